@@ -0,0 +1,35 @@
+package uuid
+
+/****************
+ * Date: 27/07/26
+ * Time: 6:22 PM
+ ***************/
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUUID_NewDCESecurity_RoundTrips(t *testing.T) {
+	u := NewDCESecurity(DomainOrg, 0xdeadbeef)
+
+	assert.Equal(t, VersionTwo, u.Version())
+	assert.Equal(t, VariantRFC4122, u.Variant())
+	assert.Equal(t, DomainOrg, u.Domain())
+	assert.Equal(t, uint32(0xdeadbeef), u.Id())
+}
+
+func TestUUID_NewDCEPerson(t *testing.T) {
+	u := NewDCEPerson()
+
+	assert.Equal(t, DomainPerson, u.Domain())
+	assert.Equal(t, posixUID, u.Id())
+}
+
+func TestUUID_NewDCEGroup(t *testing.T) {
+	u := NewDCEGroup()
+
+	assert.Equal(t, DomainGroup, u.Domain())
+	assert.Equal(t, posixGID, u.Id())
+}
@@ -0,0 +1,155 @@
+package uuid
+
+/****************
+ * Date: 27/07/26
+ * Time: 4:20 PM
+ ***************/
+
+import (
+	"encoding/gob"
+	"log"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// defaultSaveInterval is how often FileSystemSaver will persist state to
+// disk when not otherwise configured, to avoid the save goroutine
+// launched on every generator.read() hammering the disk.
+const defaultSaveInterval = 10 * time.Second
+
+// FileSystemSaver is a ready-to-use Saver that persists a Store to a file
+// on disk using an atomic write-then-rename, so a crash mid-write can
+// never leave a corrupted state file behind.
+type FileSystemSaver struct {
+	// Path is the file the state is persisted to. If empty, it defaults
+	// to $XDG_STATE_HOME/uuid/state, falling back to /var/lib/uuid/state
+	// when XDG_STATE_HOME is not set.
+	Path string
+
+	// Interval is the minimum time between two writes to disk. Saves
+	// requested more often than this are coalesced into the next tick.
+	// Defaults to defaultSaveInterval.
+	Interval time.Duration
+
+	mu       sync.Mutex
+	pending  *Store
+	lastSave time.Time
+	closed   bool
+}
+
+// Read implements Saver, loading the previously persisted Store from
+// Path. A missing file is not an error: it simply means this is the
+// first run on this host.
+func (o *FileSystemSaver) Read() (error, Store) {
+	f, err := os.Open(o.path())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, Store{}
+		}
+		return err, Store{}
+	}
+	defer f.Close()
+
+	var s Store
+	if err := gob.NewDecoder(f).Decode(&s); err != nil {
+		return err, Store{}
+	}
+
+	return nil, s
+}
+
+// Save implements Saver. It is rate limited to Interval: calls arriving
+// sooner than that just update the pending state, which is flushed by
+// the next call outside the window, or by Close.
+func (o *FileSystemSaver) Save(s *Store) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if o.closed {
+		return
+	}
+
+	o.pending = &Store{Timestamp: s.Timestamp, Sequence: s.Sequence, Node: s.Node}
+
+	if time.Since(o.lastSave) < o.interval() {
+		return
+	}
+
+	if err := o.flushLocked(); err != nil {
+		log.Println("uuid.FileSystemSaver.Save error:", err)
+	}
+}
+
+// Close flushes any pending state to disk. Call it on shutdown to avoid
+// losing state that was coalesced but not yet due to be written.
+func (o *FileSystemSaver) Close() error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	o.closed = true
+
+	return o.flushLocked()
+}
+
+// flushLocked writes o.pending to disk. The caller must hold o.mu.
+func (o *FileSystemSaver) flushLocked() error {
+	if o.pending == nil {
+		return nil
+	}
+
+	path := o.path()
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return err
+	}
+
+	if err := gob.NewEncoder(tmp).Encode(o.pending); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+
+	o.lastSave = time.Now()
+	o.pending = nil
+
+	return nil
+}
+
+func (o *FileSystemSaver) interval() time.Duration {
+	if o.Interval > 0 {
+		return o.Interval
+	}
+	return defaultSaveInterval
+}
+
+func (o *FileSystemSaver) path() string {
+	if o.Path != "" {
+		return o.Path
+	}
+	if dir := os.Getenv("XDG_STATE_HOME"); dir != "" {
+		return filepath.Join(dir, "uuid", "state")
+	}
+	return filepath.Join("/var", "lib", "uuid", "state")
+}
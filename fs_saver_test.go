@@ -0,0 +1,51 @@
+package uuid
+
+/****************
+ * Date: 27/07/26
+ * Time: 4:41 PM
+ ***************/
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileSystemSaver_SaveAndRead(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state")
+
+	saver := &FileSystemSaver{Path: path, Interval: 0}
+
+	want := &Store{Timestamp: 42, Sequence: 7, Node: Node{1, 2, 3, 4, 5, 6}}
+	saver.Save(want)
+
+	err, got := saver.Read()
+	assert.NoError(t, err)
+	assert.Equal(t, *want, got)
+}
+
+func TestFileSystemSaver_MissingFileIsNotAnError(t *testing.T) {
+	saver := &FileSystemSaver{Path: filepath.Join(t.TempDir(), "does-not-exist")}
+
+	err, got := saver.Read()
+	assert.NoError(t, err)
+	assert.Equal(t, Store{}, got)
+}
+
+func TestFileSystemSaver_RateLimitedUntilClose(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state")
+
+	saver := &FileSystemSaver{Path: path, Interval: time.Hour}
+	saver.Save(&Store{Timestamp: 1, Sequence: 1, Node: Node{1}})
+	saver.Save(&Store{Timestamp: 2, Sequence: 1, Node: Node{1}})
+
+	_, got := saver.Read()
+	assert.Equal(t, Timestamp(1), got.Timestamp, "second save within Interval should be coalesced, not yet on disk")
+
+	assert.NoError(t, saver.Close())
+
+	_, got = saver.Read()
+	assert.Equal(t, Timestamp(2), got.Timestamp, "Close should flush the latest pending state")
+}
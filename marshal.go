@@ -0,0 +1,107 @@
+package uuid
+
+/****************
+ * Date: 27/07/26
+ * Time: 3:10 PM
+ ***************/
+
+import (
+	"database/sql/driver"
+	"encoding/hex"
+	"fmt"
+)
+
+// MarshalText implements encoding.TextMarshaler, returning the canonical
+// string form of the UUID. It is used by encoding/json, encoding/xml and
+// anything else that understands TextMarshaler.
+func (o UUID) MarshalText() ([]byte, error) {
+	return []byte(o.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, parsing the
+// canonical 8-4-4-4-12 hyphenated hex form produced by String.
+func (o *UUID) UnmarshalText(text []byte) error {
+	if len(text) != 36 {
+		return fmt.Errorf("uuid: invalid UUID length: %d", len(text))
+	}
+
+	if text[8] != '-' || text[13] != '-' || text[18] != '-' || text[23] != '-' {
+		return fmt.Errorf("uuid: invalid UUID format: %q", text)
+	}
+
+	var buf [32]byte
+	copy(buf[0:8], text[0:8])
+	copy(buf[8:12], text[9:13])
+	copy(buf[12:16], text[14:18])
+	copy(buf[16:20], text[19:23])
+	copy(buf[20:32], text[24:36])
+
+	var u UUID
+	if _, err := hex.Decode(u[:], buf[:]); err != nil {
+		return fmt.Errorf("uuid: invalid UUID format: %q", text)
+	}
+
+	*o = u
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, emitting the canonical string
+// form of the UUID as a quoted JSON string.
+func (o UUID) MarshalJSON() ([]byte, error) {
+	text, _ := o.MarshalText()
+	return append(append([]byte{'"'}, text...), '"'), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It accepts a quoted
+// canonical string, or the JSON literal null, in which case the UUID is
+// left as the nil UUID.
+func (o *UUID) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*o = UUID{}
+		return nil
+	}
+
+	if len(data) < 2 || data[0] != '"' || data[len(data)-1] != '"' {
+		return fmt.Errorf("uuid: invalid JSON UUID: %s", data)
+	}
+
+	return o.UnmarshalText(data[1 : len(data)-1])
+}
+
+// Scan implements sql.Scanner, accepting the canonical 36 byte string
+// form, the raw 16 byte form, or NULL from a database driver.
+func (o *UUID) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		*o = UUID{}
+		return nil
+	case string:
+		return o.UnmarshalText([]byte(v))
+	case []byte:
+		switch len(v) {
+		case 16:
+			copy(o[:], v)
+			return nil
+		case 36:
+			return o.UnmarshalText(v)
+		default:
+			return fmt.Errorf("uuid: cannot scan %d byte value into UUID", len(v))
+		}
+	default:
+		return fmt.Errorf("uuid: cannot scan %T into UUID", src)
+	}
+}
+
+// Value implements driver.Valuer, storing the UUID as its canonical
+// string form so it round-trips through any database/sql driver without
+// needing a native uuid column type.
+func (o UUID) Value() (driver.Value, error) {
+	return o.String(), nil
+}
+
+// RawValue returns the UUID as its raw 16 byte form, suitable for
+// database/sql drivers (such as Postgres' uuid column) that accept a
+// driver.Valuer returning []byte instead of a string.
+func (o UUID) RawValue() (driver.Value, error) {
+	return o.Bytes(), nil
+}
@@ -0,0 +1,102 @@
+package uuid
+
+/****************
+ * Date: 27/07/26
+ * Time: 3:28 PM
+ ***************/
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUUID_JSONRoundTrip(t *testing.T) {
+	type holder struct {
+		ID  UUID  `json:"id"`
+		Ptr *UUID `json:"ptr"`
+	}
+
+	id := NewV5FromString(NamespaceDNS, "holder-fixture")
+	h := holder{ID: id, Ptr: &id}
+
+	data, err := json.Marshal(h)
+	assert.NoError(t, err)
+
+	var out holder
+	assert.NoError(t, json.Unmarshal(data, &out))
+	assert.Equal(t, h.ID, out.ID)
+	assert.Equal(t, *h.Ptr, *out.Ptr)
+}
+
+func TestUUID_UnmarshalJSON_Null(t *testing.T) {
+	var u UUID
+	assert.NoError(t, json.Unmarshal([]byte("null"), &u))
+	assert.Equal(t, UUID{}, u)
+}
+
+// stubDriver is a minimal database/sql/driver implementation used to
+// exercise Scan/Value without needing a real database.
+type stubDriver struct{}
+
+func (stubDriver) Open(name string) (driver.Conn, error) { return &stubConn{}, nil }
+
+type stubConn struct{}
+
+func (stubConn) Prepare(query string) (driver.Stmt, error) { return &stubStmt{}, nil }
+func (stubConn) Close() error                              { return nil }
+func (stubConn) Begin() (driver.Tx, error)                 { return nil, sql.ErrTxDone }
+
+type stubStmt struct{}
+
+func (stubStmt) Close() error  { return nil }
+func (stubStmt) NumInput() int { return -1 }
+func (stubStmt) Exec(args []driver.Value) (driver.Result, error) {
+	return driver.RowsAffected(0), nil
+}
+func (stubStmt) Query(args []driver.Value) (driver.Rows, error) {
+	return &stubRows{value: args[0]}, nil
+}
+
+type stubRows struct {
+	value driver.Value
+	done  bool
+}
+
+func (stubRows) Columns() []string { return []string{"id"} }
+func (stubRows) Close() error      { return nil }
+func (o *stubRows) Next(dest []driver.Value) error {
+	if o.done {
+		return sql.ErrNoRows
+	}
+	o.done = true
+	dest[0] = o.value
+	return nil
+}
+
+func init() {
+	sql.Register("uuidstub", stubDriver{})
+}
+
+func TestUUID_DatabaseSQLRoundTrip(t *testing.T) {
+	db, err := sql.Open("uuidstub", "")
+	assert.NoError(t, err)
+	defer db.Close()
+
+	want := NewV5FromString(NamespaceURL, "stub-fixture")
+
+	row := db.QueryRow("select ?", want)
+
+	var got UUID
+	assert.NoError(t, row.Scan(&got))
+	assert.Equal(t, want, got)
+}
+
+func TestUUID_Scan_Nil(t *testing.T) {
+	var u UUID
+	assert.NoError(t, u.Scan(nil))
+	assert.Equal(t, UUID{}, u)
+}
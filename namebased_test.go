@@ -0,0 +1,40 @@
+package uuid
+
+/****************
+ * Date: 27/07/26
+ * Time: 2:58 PM
+ ***************/
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUUID_NewV3(t *testing.T) {
+	u := NewV3FromString(NamespaceDNS, "www.example.com")
+
+	assert.Equal(t, VersionThree, u.Version())
+	assert.Equal(t, VariantRFC4122, u.Variant())
+	assert.Equal(t, u, NewV3FromString(NamespaceDNS, "www.example.com"),
+		"NewV3 must be deterministic for the same namespace and name")
+
+	// Known-answer check: catches a wrong namespace/name concatenation
+	// order or a bad MD5 truncation that version/variant/determinism
+	// checks alone would miss.
+	assert.Equal(t, "5df41881-3aed-3515-88a7-2f4a814cf09e", u.String())
+}
+
+func TestUUID_NewV5(t *testing.T) {
+	u := NewV5FromString(NamespaceDNS, "www.example.com")
+
+	assert.Equal(t, VersionFive, u.Version())
+	assert.Equal(t, VariantRFC4122, u.Variant())
+	assert.Equal(t, u, NewV5FromString(NamespaceDNS, "www.example.com"),
+		"NewV5 must be deterministic for the same namespace and name")
+
+	// Known-answer check: catches a wrong namespace/name concatenation
+	// order or a bad SHA-1 truncation that version/variant/determinism
+	// checks alone would miss.
+	assert.Equal(t, "2ed6657d-e927-568b-95e1-2665a8aea6a2", u.String())
+}
@@ -0,0 +1,15 @@
+package uuid
+
+/****************
+ * Date: 27/07/26
+ * Time: 2:20 PM
+ ***************/
+
+// Node is the last 48 bits of a v1/v2/v6 UUID. It is usually the IEEE 802
+// hardware address of the generating host or, when no such address is
+// available, a value derived from a hash of the host name (see NodeSource).
+type Node []byte
+
+// Sequence is the clock sequence used to help avoid duplicate UUIDs when
+// the system clock is set backwards, or moves to a new node ID.
+type Sequence uint16
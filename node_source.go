@@ -0,0 +1,91 @@
+package uuid
+
+/****************
+ * Date: 27/07/26
+ * Time: 3:52 PM
+ ***************/
+
+import (
+	"crypto/sha1"
+	"net"
+	"os"
+)
+
+// NodeSource supplies the node ID (the last 48 bits) used by NewV1,
+// NewDCESecurity and NewV6. Implement this, and pass it to
+// SetNodeSource, to control how the node ID is derived instead of
+// relying on the default MAC/hashed-hostname behaviour.
+type NodeSource interface {
+	// NodeID returns the node ID to use, or nil if none is available.
+	NodeID() net.HardwareAddr
+}
+
+// nodeSource is the package level NodeSource used by generator.init. A
+// nil value means the default resolution order (MACNodeSource, falling
+// back to HashedHostnameNodeSource) is used.
+var nodeSource NodeSource
+
+// SetNodeSource overrides how the package level generator resolves its
+// node ID. It should be called, like SetupSaver, before any call to
+// NewV1, NewDCESecurity or NewV6.
+func SetNodeSource(s NodeSource) {
+	nodeSource = s
+}
+
+// resolveNode returns the node ID the generator should use: the
+// configured NodeSource if one was set via SetNodeSource, otherwise the
+// first real MAC address found, falling back to a hashed hostname so
+// that restarts on a machine with no usable NIC (containers, some VMs)
+// still produce a stable node ID instead of a fresh random one.
+func resolveNode() net.HardwareAddr {
+	if nodeSource != nil {
+		return nodeSource.NodeID()
+	}
+
+	if mac := (MACNodeSource{}).NodeID(); mac != nil {
+		return mac
+	}
+
+	return (HashedHostnameNodeSource{}).NodeID()
+}
+
+// MACNodeSource returns the hardware address of the first up network
+// interface with a non-zero MAC, as reported by getHardwareAddress.
+type MACNodeSource struct{}
+
+// NodeID implements NodeSource.
+func (MACNodeSource) NodeID() net.HardwareAddr {
+	return getHardwareAddress()
+}
+
+// HashedHostnameNodeSource derives a stable, synthetic node ID from
+// os.Hostname, for hosts with no usable NIC. It is deterministic across
+// restarts on a given machine, unlike a freshly generated random node ID.
+type HashedHostnameNodeSource struct{}
+
+// NodeID implements NodeSource. It returns the first 6 bytes of the
+// SHA-1 hash of the host name, with the multicast bit set to mark it as
+// not an IEEE assigned address, or nil if the host name is unavailable.
+func (HashedHostnameNodeSource) NodeID() net.HardwareAddr {
+	host, err := os.Hostname()
+	if err != nil || host == "" {
+		return nil
+	}
+
+	sum := sha1.Sum([]byte(host))
+
+	node := make(net.HardwareAddr, 6)
+	copy(node, sum[:6])
+	node[0] |= 0x01
+
+	return node
+}
+
+// FixedNodeSource returns an explicitly configured node ID, for callers
+// that want full control over what NewV1/NewDCESecurity/NewV6 embed.
+type FixedNodeSource []byte
+
+// NodeID implements NodeSource.
+func (o FixedNodeSource) NodeID() net.HardwareAddr {
+	return net.HardwareAddr(o)
+}
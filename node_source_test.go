@@ -0,0 +1,74 @@
+package uuid
+
+/****************
+ * Date: 27/07/26
+ * Time: 6:05 PM
+ ***************/
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFixedNodeSource_NodeID(t *testing.T) {
+	want := FixedNodeSource{1, 2, 3, 4, 5, 6}
+	assert.Equal(t, net.HardwareAddr{1, 2, 3, 4, 5, 6}, want.NodeID())
+}
+
+func TestHashedHostnameNodeSource_Deterministic(t *testing.T) {
+	a := HashedHostnameNodeSource{}.NodeID()
+	b := HashedHostnameNodeSource{}.NodeID()
+
+	assert.NotNil(t, a)
+	assert.Equal(t, a, b, "the same host name must always hash to the same node ID")
+	assert.Len(t, a, 6)
+	assert.Equal(t, byte(0x01), a[0]&0x01, "multicast bit must be set to mark this as a non-IEEE address")
+}
+
+func TestResolveNode_PrefersConfiguredNodeSource(t *testing.T) {
+	defer SetNodeSource(nil)
+
+	want := FixedNodeSource{9, 9, 9, 9, 9, 9}
+	SetNodeSource(want)
+
+	assert.Equal(t, net.HardwareAddr(want), resolveNode())
+}
+
+// noNodeSource reports no node ID is available, as MACNodeSource does on
+// a host with no up interface carrying a real MAC.
+type noNodeSource struct{}
+
+func (noNodeSource) NodeID() net.HardwareAddr { return nil }
+
+func TestResolveNode_DoesNotFallBackWhenAnExplicitNodeSourceReturnsNil(t *testing.T) {
+	defer SetNodeSource(nil)
+
+	SetNodeSource(noNodeSource{})
+	assert.Nil(t, resolveNode(), "an explicitly configured NodeSource is authoritative, even if it returns nil")
+}
+
+func TestResolveNode_DefaultFallsBackToHashedHostnameWhenNoMAC(t *testing.T) {
+	if (MACNodeSource{}).NodeID() != nil {
+		t.Skip("this host has a real MAC address; cannot exercise the no-MAC fallback")
+	}
+
+	assert.Equal(t, HashedHostnameNodeSource{}.NodeID(), resolveNode())
+}
+
+// TestGeneratorInit_PersistsResolvedNode exercises generator.init directly
+// (rather than through the process-wide, once-initialized `state`
+// singleton) to verify the node ID returned by resolveNode is actually
+// written into the generator's Store, not just consulted and discarded.
+func TestGeneratorInit_PersistsResolvedNode(t *testing.T) {
+	defer SetNodeSource(nil)
+
+	want := FixedNodeSource{0xAA, 0xBB, 0xCC, 0xDD, 0xEE, 0xFF}
+	SetNodeSource(want)
+
+	g := new(generator)
+	store := g.read()
+
+	assert.Equal(t, Node(want), store.Node)
+}
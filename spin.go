@@ -0,0 +1,51 @@
+package uuid
+
+/****************
+ * Date: 27/07/26
+ * Time: 2:18 PM
+ ***************/
+
+import (
+	"time"
+)
+
+// defaultSpinResolution is the granularity a Spin will busy wait for when
+// a Saver has been configured, matching the 100ns tick rate assumed by
+// RFC 4122.
+const defaultSpinResolution = 100 * time.Nanosecond
+
+// Spin hands out strictly increasing Timestamp values, spinning on the
+// system clock when called faster than its Resolution so that two calls
+// in quick succession never observe the same tick.
+type Spin struct {
+	Resolution time.Duration
+
+	last time.Time
+}
+
+// next returns the current time as a Timestamp, guaranteed to be later
+// than the Timestamp returned by the previous call as long as the system
+// clock is not set backwards in between. It waits at least Resolution,
+// and never less than one 100ns tick, after the previous call.
+func (o *Spin) next() Timestamp {
+	resolution := o.Resolution
+	if resolution < defaultSpinResolution {
+		resolution = defaultSpinResolution
+	}
+
+	now := time.Now()
+
+	for !o.last.IsZero() && now.Sub(o.last) < resolution {
+		now = time.Now()
+	}
+
+	o.last = now
+
+	return toTimestamp(now)
+}
+
+// toTimestamp converts a time.Time into the 100-nanosecond Gregorian tick
+// count used by RFC 4122.
+func toTimestamp(t time.Time) Timestamp {
+	return Timestamp(t.UnixNano()/100 + gregorianToUNIXOffset)
+}
@@ -11,6 +11,7 @@ import (
 	"encoding/binary"
 	"log"
 	"net"
+	"sort"
 	"sync"
 	"os"
 	"fmt"
@@ -139,7 +140,7 @@ func (o *generator) init() {
 	now := o.Spin.next()
 
 	//  Get the current node ID.
-	node := getHardwareAddress()
+	node := resolveNode()
 
 	// If the state was unavailable (e.g., non-existent or corrupted), or
 	// the saved node ID is different than the current node ID, generate
@@ -165,7 +166,7 @@ func (o *generator) init() {
 		// across systems.  This provides maximum protection against node
 		// identifiers that may move or switch from system to system rapidly.
 		// The initial value MUST NOT be correlated to the node identifier.
-		err := binary.Read(rand.Reader, binary.LittleEndian, storage.Sequence)
+		err := binary.Read(rand.Reader, binary.LittleEndian, &storage.Sequence)
 		if err != nil {
 			log.Println("uuid.State.init error:", err)
 		} else {
@@ -179,6 +180,11 @@ func (o *generator) init() {
 		storage.Sequence++;
 	}
 
+	// Record the node ID this process is actually using, so it is both
+	// returned to callers and, if a Saver is configured, persisted for
+	// the next comparison above on the following restart.
+	storage.Node = Node(node)
+
 	o.Store = &storage
 }
 
@@ -192,34 +198,37 @@ func (o *generator) save() {
 	}
 }
 
+// getHardwareAddress returns the hardware address of the first up network
+// interface with a non-zero MAC, or nil if none was found. Interfaces are
+// visited in a deterministic (name-sorted) order so that, on a host with
+// more than one candidate NIC, repeated calls and process restarts agree
+// on which one is picked.
 func getHardwareAddress() (node net.HardwareAddr) {
 	interfaces, err := net.Interfaces()
-	if err == nil {
-		for _, i := range interfaces {
-			// Initially I could multicast out the Flags to get
-			// whether the interface was up but started failing
-			if (i.Flags & (1 << net.FlagUp)) != 0 {
-				//if inter.Flags.String() != "0" {
-				if addrs, err := i.Addrs(); err == nil {
-					for _, a := range addrs {
-						if a.String() != "0.0.0.0" && !bytes.Equal(i.HardwareAddr, make([]byte, len(i.HardwareAddr))) {
-							// Don't use random as we have a real address
-							node = i.HardwareAddr
-							return
-						}
+	if err != nil {
+		log.Println("uuid.State.init: address error:", err)
+		return nil
+	}
+
+	sort.Slice(interfaces, func(i, j int) bool {
+		return interfaces[i].Name < interfaces[j].Name
+	})
+
+	for _, i := range interfaces {
+		// Initially I could multicast out the Flags to get
+		// whether the interface was up but started failing
+		if (i.Flags & (1 << net.FlagUp)) != 0 {
+			//if inter.Flags.String() != "0" {
+			if addrs, err := i.Addrs(); err == nil {
+				for _, a := range addrs {
+					if a.String() != "0.0.0.0" && !bytes.Equal(i.HardwareAddr, make([]byte, len(i.HardwareAddr))) {
+						node = i.HardwareAddr
+						return
 					}
 				}
 			}
 		}
 	}
-	log.Println("uuid.State.init: address error: will generate random node id instead", err)
-
-	node = make([]byte, 6)
 
-	if _, err := rand.Read(node); err != nil {
-		log.Panicln("uuid.getHardwareAddress: could not get cryto random bytes", err)
-	}
-	// Mark as randomly generated
-	node[0] |= 0x01
-	return
+	return nil
 }
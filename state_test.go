@@ -31,11 +31,16 @@ func TestUUID_getHardwareAddress(t *testing.T) {
 
 func TestUUID_StateSeed(t *testing.T) {
 
+	// state.Store is only populated lazily, the first time something
+	// calls read()/init(). Trigger that here so the promoted field
+	// accesses below don't dereference a nil *Store.
+	state.read()
+
 	assert.True(t, state.Timestamp > Timestamp((1391463463*10000000)+(100*10)+gregorianToUNIXOffset),
-		"Expected a value greater than 02/03/2014 @ 9:37pm in UTC but got %s", state.Timestamp)
+		"Expected a value greater than 02/03/2014 @ 9:37pm in UTC but got %d", state.Timestamp)
 
 	if state.Timestamp < Timestamp((1391463463*10000000)+(100*10)+gregorianToUNIXOffset) {
-		t.Errorf("Expected a value greater than 02/03/2014 @ 9:37pm in UTC but got %s", state.Timestamp)
+		t.Errorf("Expected a value greater than 02/03/2014 @ 9:37pm in UTC but got %d", state.Timestamp)
 	}
 	if state.Node == nil {
 		t.Errorf("Expected a non nil node")
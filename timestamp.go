@@ -0,0 +1,16 @@
+package uuid
+
+/****************
+ * Date: 27/07/26
+ * Time: 2:14 PM
+ ***************/
+
+// Timestamp is a 60 bit count of 100-nanosecond intervals since
+// 00:00:00.00, 15 October 1582 (the start of the Gregorian calendar), as
+// used by UUID versions 1, 2 and 6.
+type Timestamp uint64
+
+// gregorianToUNIXOffset is the number of 100-nanosecond intervals between
+// the Gregorian epoch (1582-10-15 00:00:00) and the UNIX epoch
+// (1970-01-01 00:00:00).
+const gregorianToUNIXOffset = 0x01b21dd213814000
@@ -0,0 +1,90 @@
+package uuid
+
+/****************
+ * Date: 27/07/26
+ * Time: 2:10 PM
+ ***************/
+
+import (
+	"encoding/hex"
+)
+
+// UUID is a 128 bit (16 byte) Universal Unique IDentifier as defined in
+// RFC 4122.
+type UUID [16]byte
+
+// The variant values defined by RFC 4122, stored in the one to three most
+// significant bits of octet 8.
+const (
+	VariantNCS       byte = 0x00
+	VariantRFC4122   byte = 0x80
+	VariantMicrosoft byte = 0xc0
+	VariantFuture    byte = 0xe0
+)
+
+// Version represents the sub-type of a UUID as held in the top nibble of
+// octet 6.
+type Version byte
+
+const (
+	_ Version = iota
+	VersionOne
+	VersionTwo
+	VersionThree
+	VersionFour
+	VersionFive
+	VersionSix
+	VersionSeven
+)
+
+// Bytes returns the raw 16 byte representation of the UUID.
+func (o UUID) Bytes() []byte {
+	return o[:]
+}
+
+// String returns the canonical 8-4-4-4-12 hyphenated hex representation of
+// the UUID, e.g. "6ba7b810-9dad-11d1-80b4-00c04fd430c8".
+func (o UUID) String() string {
+	buf := make([]byte, 36)
+
+	hex.Encode(buf[0:8], o[0:4])
+	buf[8] = '-'
+	hex.Encode(buf[9:13], o[4:6])
+	buf[13] = '-'
+	hex.Encode(buf[14:18], o[6:8])
+	buf[18] = '-'
+	hex.Encode(buf[19:23], o[8:10])
+	buf[23] = '-'
+	hex.Encode(buf[24:], o[10:16])
+
+	return string(buf)
+}
+
+// Version returns the version number held in the UUID.
+func (o UUID) Version() Version {
+	return Version(o[6] >> 4)
+}
+
+// setVersion overwrites the version nibble of the UUID.
+func (o *UUID) setVersion(v Version) {
+	o[6] = (o[6] & 0x0f) | (byte(v) << 4)
+}
+
+// Variant returns the layout variant of the UUID.
+func (o UUID) Variant() byte {
+	switch {
+	case o[8]&0x80 == VariantNCS:
+		return VariantNCS
+	case o[8]&0xc0 == VariantRFC4122:
+		return VariantRFC4122
+	case o[8]&0xe0 == VariantMicrosoft:
+		return VariantMicrosoft
+	}
+	return VariantFuture
+}
+
+// setVariant overwrites the variant bits of the UUID to mark it as
+// RFC 4122 layout.
+func (o *UUID) setVariant() {
+	o[8] = (o[8] & 0x3f) | VariantRFC4122
+}
@@ -0,0 +1,36 @@
+package uuid
+
+/****************
+ * Date: 27/07/26
+ * Time: 2:24 PM
+ ***************/
+
+import (
+	"encoding/binary"
+)
+
+// state is the single package level generator backing NewV1,
+// NewDCESecurity, NewV6 and NewV7. Its Store is shared (and optionally
+// persisted via SetupSaver) so that successive calls never hand out the
+// same timestamp/clock sequence/node triple.
+var state = new(generator)
+
+// NewV1 returns a new time based (version 1) UUID built from the current
+// timestamp, clock sequence and node ID held by the package level
+// generator.
+func NewV1() UUID {
+	store := state.read()
+
+	var u UUID
+
+	binary.BigEndian.PutUint32(u[0:4], uint32(store.Timestamp))
+	binary.BigEndian.PutUint16(u[4:6], uint16(store.Timestamp>>32))
+	binary.BigEndian.PutUint16(u[6:8], uint16(store.Timestamp>>48))
+	binary.BigEndian.PutUint16(u[8:10], uint16(store.Sequence))
+	copy(u[10:16], store.Node)
+
+	u.setVersion(VersionOne)
+	u.setVariant()
+
+	return u
+}
@@ -0,0 +1,27 @@
+package uuid
+
+/****************
+ * Date: 27/07/26
+ * Time: 5:34 PM
+ ***************/
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUUID_NewV6(t *testing.T) {
+	u := NewV6()
+
+	assert.Equal(t, VersionSix, u.Version())
+	assert.Equal(t, VariantRFC4122, u.Variant())
+}
+
+func TestUUID_NewV6_Monotonic(t *testing.T) {
+	a := NewV6()
+	b := NewV6()
+
+	assert.True(t, a.String() < b.String(),
+		"successive V6 UUIDs must sort ahead of each other: %s, %s", a, b)
+}
@@ -0,0 +1,85 @@
+package uuid
+
+/****************
+ * Date: 27/07/26
+ * Time: 5:18 PM
+ ***************/
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"log"
+	"time"
+)
+
+// v7Clock holds the extra monotonic state NewV7 needs on top of the
+// v1-style Store held by generator: the last millisecond a v7 UUID was
+// minted for, and the 12 bit counter (rand_a) used to keep UUIDs
+// generated within that millisecond strictly increasing.
+type v7Clock struct {
+	lastMs uint64
+	tail   uint16
+}
+
+var v7 v7Clock
+
+// NewV7 returns a new version 7 UUID: a 48 bit big-endian Unix epoch
+// millisecond timestamp, followed by the version nibble, a 12 bit
+// monotonic counter, the RFC 4122 variant, and 62 further random bits.
+//
+// Two calls landing in the same millisecond are made strictly increasing
+// by incrementing the 12 bit counter instead of redrawing it; should the
+// counter overflow before the clock ticks forward, the millisecond is
+// advanced by hand so ordering is never lost. It shares the package
+// level generator's mutex and Saver with NewV1/NewV6, so a configured
+// Saver still observes consistent, serialized state across all three.
+func NewV7() UUID {
+	state.Do(state.init)
+
+	state.Lock()
+	defer state.Unlock()
+	defer state.save()
+
+	now := uint64(time.Now().UnixMilli())
+
+	switch {
+	case now > v7.lastMs:
+		v7.lastMs = now
+		v7.tail = randomCounter()
+	default:
+		if now < v7.lastMs {
+			now = v7.lastMs
+		}
+		v7.tail = (v7.tail + 1) & 0x0fff
+		if v7.tail == 0 {
+			v7.lastMs++
+			now = v7.lastMs
+		}
+	}
+
+	var u UUID
+
+	binary.BigEndian.PutUint32(u[0:4], uint32(now>>16))
+	binary.BigEndian.PutUint16(u[4:6], uint16(now))
+
+	u[6] = byte(v7.tail >> 8)
+	u[7] = byte(v7.tail)
+	u.setVersion(VersionSeven)
+
+	if _, err := rand.Read(u[8:16]); err != nil {
+		log.Panicln("uuid.NewV7: could not get crypto random bytes", err)
+	}
+	u.setVariant()
+
+	return u
+}
+
+// randomCounter draws the initial value for v7Clock.tail when entering a
+// new millisecond.
+func randomCounter() uint16 {
+	var b [2]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		log.Panicln("uuid.NewV7: could not get crypto random bytes", err)
+	}
+	return binary.BigEndian.Uint16(b[:]) & 0x0fff
+}
@@ -0,0 +1,33 @@
+package uuid
+
+/****************
+ * Date: 27/07/26
+ * Time: 5:40 PM
+ ***************/
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUUID_NewV7(t *testing.T) {
+	u := NewV7()
+
+	assert.Equal(t, VersionSeven, u.Version())
+	assert.Equal(t, VariantRFC4122, u.Variant())
+}
+
+func TestUUID_NewV7_MonotonicWithinSameMillisecond(t *testing.T) {
+	const n = 1000
+
+	uuids := make([]UUID, n)
+	for i := range uuids {
+		uuids[i] = NewV7()
+	}
+
+	for i := 1; i < n; i++ {
+		assert.True(t, uuids[i-1].String() < uuids[i].String(),
+			"successive V7 UUIDs must sort ahead of each other: %s, %s", uuids[i-1], uuids[i])
+	}
+}